@@ -0,0 +1,154 @@
+// internal/application/place_order/batch.go
+package placeorder
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"myapp/internal/application/ports"
+)
+
+// CreatedOrder is a command that was placed successfully within a batch.
+type CreatedOrder struct {
+	Index   int
+	OrderID string
+}
+
+// FailedOrder is a command that could not be placed within a batch, after
+// RetryPolicy.MaxAttempts was exhausted or the failure was classified as
+// non-retryable.
+type FailedOrder struct {
+	Index int
+	Err   error
+}
+
+// BatchResult is the outcome of a BatchHandler run. Created and Failed
+// both carry the original Index into the input slice, since the batch
+// never aborts on the first failure and results can complete out of
+// submission order.
+type BatchResult struct {
+	Created []CreatedOrder
+	Failed  []FailedOrder
+}
+
+// BatchOptions configures a BatchHandler.
+type BatchOptions struct {
+	// Concurrency bounds how many commands run at once. Defaults to 1
+	// (sequential) if zero or negative.
+	Concurrency int
+
+	// RetryPolicy controls per-command retries. Defaults to
+	// DefaultRetryPolicy if MaxAttempts is zero.
+	RetryPolicy RetryPolicy
+}
+
+// BatchHandler places multiple orders, retrying transient per-command
+// failures individually rather than aborting the whole batch on the
+// first error, mirroring how exchange order-submission APIs separate a
+// multi-order SubmitOrders call from single-order SubmitOrder.
+type BatchHandler struct {
+	handler *Handler
+	metrics ports.MetricsRecorder
+	opts    BatchOptions
+}
+
+// NewBatchHandler creates a BatchHandler that delegates each command to
+// handler. metrics may be nil, in which case no counters are recorded.
+func NewBatchHandler(handler *Handler, metrics ports.MetricsRecorder, opts BatchOptions) *BatchHandler {
+	if opts.RetryPolicy.MaxAttempts == 0 {
+		opts.RetryPolicy = DefaultRetryPolicy()
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+	return &BatchHandler{handler: handler, metrics: metrics, opts: opts}
+}
+
+// Handle places every command in cmds, running up to opts.Concurrency at
+// once, and returns once all of them have either succeeded or exhausted
+// their retries. Each command's underlying Handler.Handle call - and so
+// its order/outbox write - remains individually atomic; a failure in one
+// command never affects another.
+func (b *BatchHandler) Handle(ctx context.Context, cmds []Command) BatchResult {
+	results := make([]result, len(cmds))
+
+	sem := make(chan struct{}, b.opts.Concurrency)
+	var wg sync.WaitGroup
+
+	for i, cmd := range cmds {
+		wg.Add(1)
+		go func(i int, cmd Command) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = b.runWithRetry(ctx, cmd)
+		}(i, cmd)
+	}
+	wg.Wait()
+
+	var out BatchResult
+	for i, r := range results {
+		if r.err != nil {
+			out.Failed = append(out.Failed, FailedOrder{Index: i, Err: r.err})
+			continue
+		}
+		out.Created = append(out.Created, CreatedOrder{Index: i, OrderID: r.orderID})
+	}
+	return out
+}
+
+// result is the outcome of a single command, before it is split into
+// BatchResult.Created/Failed.
+type result struct {
+	orderID string
+	err     error
+}
+
+// runWithRetry places a single command, retrying per b.opts.RetryPolicy
+// while the classifier considers the failure transient, and recording
+// success/failure/retry counters along the way.
+func (b *BatchHandler) runWithRetry(ctx context.Context, cmd Command) result {
+	policy := b.opts.RetryPolicy
+	var lastErr error
+
+	for attempt := 1; attempt <= policy.maxAttempts(); attempt++ {
+		orderID, err := b.handler.Handle(ctx, cmd)
+		if err == nil {
+			b.recordOutcome(true, false)
+			return result{orderID: orderID}
+		}
+
+		lastErr = err
+		willRetry := policy.retryable(err) && attempt < policy.maxAttempts()
+		b.recordOutcome(false, willRetry)
+
+		if !willRetry {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return result{err: ctx.Err()}
+		case <-time.After(policy.backoffFor(attempt)):
+		}
+	}
+
+	return result{err: lastErr}
+}
+
+// recordOutcome reports success/failure/retry counters via the injected
+// MetricsRecorder, if one was provided.
+func (b *BatchHandler) recordOutcome(success, retrying bool) {
+	if b.metrics == nil {
+		return
+	}
+	switch {
+	case success:
+		b.metrics.IncrCounter("placeorder.batch.success", 1)
+	case retrying:
+		b.metrics.IncrCounter("placeorder.batch.retry", 1)
+	default:
+		b.metrics.IncrCounter("placeorder.batch.failure", 1)
+	}
+}
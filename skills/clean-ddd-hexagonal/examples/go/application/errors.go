@@ -0,0 +1,18 @@
+// internal/application/place_order/errors.go
+package placeorder
+
+import "errors"
+
+// Sentinel errors returned by Handler and classified by RetryPolicy when
+// BatchHandler decides whether a failed command is worth retrying.
+var (
+	// ErrProductNotFound indicates a requested product does not exist.
+	// This is a validation failure, not a transient fault, so it should
+	// never be retried.
+	ErrProductNotFound = errors.New("placeorder: product not found")
+
+	// ErrTransientRepo indicates a repository operation failed for a
+	// reason expected to be temporary, such as a dropped connection or a
+	// timeout. Retrying the same command may succeed.
+	ErrTransientRepo = errors.New("placeorder: transient repository error")
+)
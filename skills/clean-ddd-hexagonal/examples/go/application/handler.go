@@ -3,14 +3,18 @@ package placeorder
 
 import (
 	"context"
+	"fmt"
 
 	"myapp/internal/application/ports"
 	"myapp/internal/domain/order"
+	"myapp/internal/domain/payment"
 )
 
 // Handler implements the place order use case.
 // It orchestrates the workflow of creating an order, validating products,
-// persisting the aggregate, and publishing domain events.
+// optionally authorizing payment, and persisting the aggregate along with
+// its domain events. Publishing those events to external subscribers is
+// the outbox Relay's job, not Handler's.
 //
 // Handler depends on abstractions (ports) rather than concrete implementations,
 // following the Dependency Inversion Principle. Dependencies are injected
@@ -18,7 +22,27 @@ import (
 type Handler struct {
 	orderRepo      order.Repository
 	productRepo    ports.ProductRepository
-	eventPublisher ports.EventPublisher
+	paymentGateway ports.PaymentGateway
+	paymentRepo    payment.Repository
+}
+
+// HandlerOption configures optional Handler behavior.
+type HandlerOption func(*Handler)
+
+// WithPayment enables payment authorization as part of Handle: once the
+// order's items are populated, Handler authorizes a Payment for its total
+// through gateway and drives the order through AwaitPayment/MarkPaid
+// before persisting it. If the subsequent order save fails, the
+// authorization is voided so the customer is not left holding a
+// authorization for an order that was never created.
+//
+// Without this option, Handle places orders with no payment step, as
+// before.
+func WithPayment(gateway ports.PaymentGateway, paymentRepo payment.Repository) HandlerOption {
+	return func(h *Handler) {
+		h.paymentGateway = gateway
+		h.paymentRepo = paymentRepo
+	}
 }
 
 // NewHandler creates a Handler with the required dependencies.
@@ -26,27 +50,30 @@ type Handler struct {
 func NewHandler(
 	orderRepo order.Repository,
 	productRepo ports.ProductRepository,
-	eventPublisher ports.EventPublisher,
+	opts ...HandlerOption,
 ) *Handler {
-	return &Handler{
-		orderRepo:      orderRepo,
-		productRepo:    productRepo,
-		eventPublisher: eventPublisher,
+	h := &Handler{
+		orderRepo:   orderRepo,
+		productRepo: productRepo,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // Handle executes the place order use case.
-// It creates a new order with the specified items, persists it,
-// and publishes any resulting domain events.
+// It creates a new order with the specified items, authorizes payment for
+// it when WithPayment was configured, and persists it together with its
+// domain events via the transactional outbox, so publishing survives an
+// outage of the event publisher.
 //
 // Returns the new order's ID on success, or an error if:
 //   - The customer ID is invalid
-//   - Any product cannot be found
-//   - The order fails to save
-//   - Event publishing fails
-//
-// Note: This implementation publishes events after persistence. In a
-// production system, consider using the Outbox pattern for reliability.
+//   - A product cannot be found (wraps ErrProductNotFound)
+//   - The product lookup or order save fails for a repository reason
+//     (wraps ErrTransientRepo, so BatchHandler's RetryPolicy can retry it)
+//   - Payment authorization fails
 func (h *Handler) Handle(ctx context.Context, cmd Command) (string, error) {
 	customerID, err := order.CustomerIDFrom(cmd.CustomerID)
 	if err != nil {
@@ -58,7 +85,10 @@ func (h *Handler) Handle(ctx context.Context, cmd Command) (string, error) {
 	for _, item := range cmd.Items {
 		product, err := h.productRepo.FindByID(ctx, item.ProductID)
 		if err != nil {
-			return "", err
+			return "", fmt.Errorf("%w: %v", ErrTransientRepo, err)
+		}
+		if product == nil {
+			return "", fmt.Errorf("%w: %s", ErrProductNotFound, item.ProductID)
 		}
 
 		productID, _ := order.ProductIDFrom(item.ProductID)
@@ -67,17 +97,64 @@ func (h *Handler) Handle(ctx context.Context, cmd Command) (string, error) {
 		}
 	}
 
-	if err := h.orderRepo.Save(ctx, newOrder); err != nil {
-		return "", err
+	var authorization ports.PaymentAuthorization
+	if h.paymentGateway != nil {
+		authorization, err = h.authorizePayment(ctx, newOrder)
+		if err != nil {
+			return "", err
+		}
 	}
 
-	// Publish domain events after successful persistence
-	for _, event := range newOrder.Events() {
-		if err := h.eventPublisher.Publish(ctx, event); err != nil {
-			return "", err
+	if err := h.orderRepo.SaveWithOutbox(ctx, newOrder, newOrder.Events()); err != nil {
+		if h.paymentGateway != nil {
+			// The order never committed, so the authorization must not be
+			// allowed to settle; best-effort void and surface the save error.
+			_ = h.paymentGateway.Void(ctx, authorization.ProviderReference)
 		}
+		return "", fmt.Errorf("%w: %v", ErrTransientRepo, err)
 	}
 	newOrder.ClearEvents()
 
 	return newOrder.ID().String(), nil
 }
+
+// authorizePayment authorizes a payment for newOrder's total, records a
+// Payment aggregate for it, and drives newOrder through
+// AwaitPayment/MarkPaid so it reaches Confirmed status before Save.
+func (h *Handler) authorizePayment(ctx context.Context, newOrder *order.Order) (ports.PaymentAuthorization, error) {
+	if err := newOrder.AwaitPayment(); err != nil {
+		return ports.PaymentAuthorization{}, err
+	}
+
+	// Payments are authorized in the order's own settlement currency, so
+	// no FXRateProvider is needed here.
+	total, err := newOrder.Total(ctx, nil, newOrder.Currency())
+	if err != nil {
+		return ports.PaymentAuthorization{}, err
+	}
+
+	authorization, err := h.paymentGateway.Authorize(ctx, total)
+	if err != nil {
+		return ports.PaymentAuthorization{}, err
+	}
+
+	provider, err := payment.NewProvider(authorization.ProviderName, authorization.ProviderReference)
+	if err != nil {
+		return ports.PaymentAuthorization{}, err
+	}
+
+	newPayment := payment.NewPayment(newOrder.ID(), total, provider)
+	if err := h.paymentRepo.Save(ctx, newPayment); err != nil {
+		return ports.PaymentAuthorization{}, err
+	}
+
+	paymentID, err := order.PaymentIDFrom(newPayment.ID().String())
+	if err != nil {
+		return ports.PaymentAuthorization{}, err
+	}
+	if err := newOrder.MarkPaid(paymentID); err != nil {
+		return ports.PaymentAuthorization{}, err
+	}
+
+	return authorization, nil
+}
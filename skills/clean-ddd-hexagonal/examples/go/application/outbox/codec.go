@@ -0,0 +1,65 @@
+// internal/application/outbox/codec.go
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"myapp/internal/domain/shared"
+)
+
+// DomainEventCodec serializes domain events for storage in the outbox
+// table and reconstructs them on the way out, so the Relay can hand
+// concrete event types to ports.EventPublisher rather than raw bytes.
+type DomainEventCodec interface {
+	// Encode serializes event, returning its EventType and payload.
+	Encode(event shared.DomainEvent) (EncodedEvent, error)
+
+	// Decode reconstructs a domain event from a previously encoded
+	// EventType and payload. Returns an error if eventType is not
+	// registered with the codec.
+	Decode(eventType string, payload []byte) (shared.DomainEvent, error)
+}
+
+// EventDecoder unmarshals a payload into a concrete shared.DomainEvent.
+type EventDecoder func(payload []byte) (shared.DomainEvent, error)
+
+// JSONCodec is the default DomainEventCodec. It marshals events as JSON
+// and decodes them via a registry of decoders keyed by EventType(), since
+// JSON alone cannot recover the concrete Go type behind the
+// shared.DomainEvent interface.
+type JSONCodec struct {
+	decoders map[string]EventDecoder
+}
+
+// NewJSONCodec creates an empty JSONCodec. Call Register for every event
+// type the application needs to round-trip through the outbox.
+func NewJSONCodec() *JSONCodec {
+	return &JSONCodec{decoders: make(map[string]EventDecoder)}
+}
+
+// Register associates an event type with the decoder used to reconstruct
+// it. Typically called once per event during application start-up, e.g.
+// codec.Register("order.created", order.DecodeOrderCreatedEvent).
+func (c *JSONCodec) Register(eventType string, decode EventDecoder) {
+	c.decoders[eventType] = decode
+}
+
+// Encode marshals event as JSON.
+func (c *JSONCodec) Encode(event shared.DomainEvent) (EncodedEvent, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return EncodedEvent{}, fmt.Errorf("outbox: encode %s: %w", event.EventType(), err)
+	}
+	return EncodedEvent{EventType: event.EventType(), Payload: payload}, nil
+}
+
+// Decode looks up the decoder registered for eventType and uses it to
+// reconstruct the event from payload.
+func (c *JSONCodec) Decode(eventType string, payload []byte) (shared.DomainEvent, error) {
+	decode, ok := c.decoders[eventType]
+	if !ok {
+		return nil, fmt.Errorf("outbox: no decoder registered for event type %q", eventType)
+	}
+	return decode(payload)
+}
@@ -0,0 +1,150 @@
+// internal/application/outbox/relay.go
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"myapp/internal/application/ports"
+)
+
+// DefaultMaxAttempts is the number of delivery attempts a Relay makes
+// before moving a row to the dead-letter state.
+const DefaultMaxAttempts = 8
+
+// DefaultBatchSize is the number of rows a Relay polls per tick.
+const DefaultBatchSize = 100
+
+// Relay polls a Store for unpublished events and forwards them to a
+// ports.EventPublisher, giving at-least-once delivery without requiring
+// the use case that produced the events to publish them synchronously.
+// Failed deliveries are retried with exponential backoff up to
+// MaxAttempts, after which the row is dead-lettered for manual
+// inspection rather than retried forever.
+type Relay struct {
+	store       Store
+	publisher   ports.EventPublisher
+	codec       DomainEventCodec
+	batchSize   int
+	maxAttempts int
+	backoff     func(attempt int) time.Duration
+	onPollError func(error)
+}
+
+// RelayOption configures optional Relay behavior.
+type RelayOption func(*Relay)
+
+// WithBatchSize overrides DefaultBatchSize.
+func WithBatchSize(n int) RelayOption {
+	return func(r *Relay) { r.batchSize = n }
+}
+
+// WithMaxAttempts overrides DefaultMaxAttempts.
+func WithMaxAttempts(n int) RelayOption {
+	return func(r *Relay) { r.maxAttempts = n }
+}
+
+// WithBackoff overrides the default exponential backoff function.
+func WithBackoff(backoff func(attempt int) time.Duration) RelayOption {
+	return func(r *Relay) { r.backoff = backoff }
+}
+
+// WithPollErrorHandler overrides how Run reports a failed poll (e.g. a
+// transient Store error). The default logs nowhere and simply retries on
+// the next tick; set this to observe failures without stopping the relay.
+func WithPollErrorHandler(onPollError func(error)) RelayOption {
+	return func(r *Relay) { r.onPollError = onPollError }
+}
+
+// NewRelay creates a Relay with the required dependencies and applies any
+// RelayOptions, falling back to DefaultBatchSize, DefaultMaxAttempts, and
+// an exponential backoff capped at five minutes.
+func NewRelay(store Store, publisher ports.EventPublisher, codec DomainEventCodec, opts ...RelayOption) *Relay {
+	r := &Relay{
+		store:       store,
+		publisher:   publisher,
+		codec:       codec,
+		batchSize:   DefaultBatchSize,
+		maxAttempts: DefaultMaxAttempts,
+		backoff:     exponentialBackoff,
+		onPollError: func(error) {},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// exponentialBackoff doubles the delay per attempt, capped at five
+// minutes, so a flaky publisher does not get hammered with retries.
+func exponentialBackoff(attempt int) time.Duration {
+	const cap = 5 * time.Minute
+	delay := time.Second << uint(attempt)
+	if delay <= 0 || delay > cap {
+		return cap
+	}
+	return delay
+}
+
+// Run polls the Store on the given interval until ctx is cancelled. It is
+// intended to be started as a background worker, e.g. `go relay.Run(ctx,
+// 5*time.Second)`. A poll that fails outright (e.g. the Store is
+// unreachable) is reported via onPollError and retried on the next tick
+// rather than stopping the relay; only ctx's own cancellation ends Run.
+func (r *Relay) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.poll(ctx); err != nil {
+			r.onPollError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll delivers a single batch of unpublished rows. A row whose delivery
+// fails is rescheduled with backoff or dead-lettered; it does not stop
+// delivery of the rest of the batch.
+func (r *Relay) poll(ctx context.Context) error {
+	records, err := r.store.FindUnpublished(ctx, r.batchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		r.deliver(ctx, record)
+	}
+	return nil
+}
+
+// deliver attempts to publish a single outbox row and updates its state
+// accordingly. Errors are absorbed into the row's attempt/backoff
+// bookkeeping rather than returned, since one bad event should not halt
+// the relay loop.
+func (r *Relay) deliver(ctx context.Context, record Record) {
+	event, err := r.codec.Decode(record.EventType, record.Payload)
+	if err != nil {
+		// The payload cannot be decoded and retrying will not help;
+		// dead-letter it immediately.
+		_ = r.store.MarkFailed(ctx, record.ID, record.Attempts+1, time.Time{}, true)
+		return
+	}
+
+	if err := r.publisher.Publish(ctx, event); err != nil {
+		attempts := record.Attempts + 1
+		if attempts >= r.maxAttempts {
+			_ = r.store.MarkFailed(ctx, record.ID, attempts, time.Time{}, true)
+			return
+		}
+		_ = r.store.MarkFailed(ctx, record.ID, attempts, time.Now().Add(r.backoff(attempts)), false)
+		return
+	}
+
+	_ = r.store.MarkPublished(ctx, record.ID)
+}
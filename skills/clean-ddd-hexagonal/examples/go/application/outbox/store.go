@@ -0,0 +1,55 @@
+// internal/application/outbox/store.go
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Record is a single outbox row awaiting delivery to an external
+// subscriber. Rows are written in the same transaction as the aggregate
+// change that produced the underlying domain event, then delivered
+// asynchronously by a Relay.
+type Record struct {
+	ID            string
+	EventType     string
+	Payload       []byte
+	Attempts      int
+	NextAttemptAt time.Time
+	DeadLetter    bool
+	CreatedAt     time.Time
+}
+
+// Store is a driven port for persisting and retrieving outbox rows. It is
+// deliberately split between a transactional write (Save) and
+// non-transactional reads/updates (the rest), since writes must commit
+// atomically with the aggregate change that produced the events while
+// polling and delivery bookkeeping happen outside of that transaction.
+type Store interface {
+	// Save writes already-encoded events to the outbox using tx, so the
+	// rows commit atomically with whatever aggregate write tx also
+	// contains. Callers are responsible for committing tx.
+	Save(ctx context.Context, tx *sql.Tx, events []EncodedEvent) error
+
+	// FindUnpublished returns up to limit rows that are not yet published,
+	// not dead-lettered, and due for a delivery attempt (NextAttemptAt is
+	// zero or in the past), ordered by CreatedAt.
+	FindUnpublished(ctx context.Context, limit int) ([]Record, error)
+
+	// MarkPublished marks a row as successfully delivered.
+	MarkPublished(ctx context.Context, id string) error
+
+	// MarkFailed records a failed delivery attempt. If deadLetter is true
+	// the row is moved to the dead-letter state and will no longer be
+	// returned by FindUnpublished; otherwise nextAttemptAt schedules the
+	// next retry.
+	MarkFailed(ctx context.Context, id string, attempts int, nextAttemptAt time.Time, deadLetter bool) error
+}
+
+// EncodedEvent is a domain event that has already been serialized by a
+// DomainEventCodec, ready to be written to the outbox table.
+type EncodedEvent struct {
+	EventType string
+	Payload   []byte
+}
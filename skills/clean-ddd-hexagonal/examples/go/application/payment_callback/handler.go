@@ -0,0 +1,110 @@
+// internal/application/payment_callback/handler.go
+package paymentcallback
+
+import (
+	"context"
+	"fmt"
+
+	"myapp/internal/domain/order"
+	"myapp/internal/domain/payment"
+)
+
+// Callback is the normalized shape of a payment provider's webhook
+// payload, after provider-specific signature verification and parsing
+// has already happened upstream of Handler.
+type Callback struct {
+	PaymentID string
+	Status    payment.Status
+}
+
+// Handler consumes payment provider webhook callbacks and drives the
+// corresponding Order through its state machine. Payment truth (approved,
+// refused, refunded) lives in the Payment aggregate; this handler is what
+// keeps Order in sync with it, rather than Order reaching into the
+// payment gateway directly.
+type Handler struct {
+	paymentRepo payment.Repository
+	orderRepo   order.Repository
+}
+
+// NewHandler creates a Handler with the required dependencies.
+func NewHandler(paymentRepo payment.Repository, orderRepo order.Repository) *Handler {
+	return &Handler{paymentRepo: paymentRepo, orderRepo: orderRepo}
+}
+
+// Handle applies a single provider callback: it loads the Payment the
+// callback refers to, transitions it, then drives the associated Order
+// through MarkPaid, Cancel, or Refund as appropriate.
+//
+// A callback reporting a status the payment has already reached is a
+// no-op rather than an error, so a provider redelivering a webhook does
+// not fail or double-apply the transition.
+func (h *Handler) Handle(ctx context.Context, cb Callback) error {
+	paymentID, err := payment.PaymentIDFrom(cb.PaymentID)
+	if err != nil {
+		return err
+	}
+
+	pay, err := h.paymentRepo.FindByID(ctx, paymentID)
+	if err != nil {
+		return err
+	}
+	if pay == nil {
+		return fmt.Errorf("paymentcallback: unknown payment %s", cb.PaymentID)
+	}
+	if pay.Status() == cb.Status {
+		return nil
+	}
+
+	o, err := h.orderRepo.FindByID(ctx, pay.OrderID())
+	if err != nil {
+		return err
+	}
+	if o == nil {
+		return fmt.Errorf("paymentcallback: order %s not found for payment %s", pay.OrderID().String(), cb.PaymentID)
+	}
+
+	switch cb.Status {
+	case payment.StatusApproved:
+		if err := pay.Approve(); err != nil {
+			return err
+		}
+		orderPaymentID, err := order.PaymentIDFrom(pay.ID().String())
+		if err != nil {
+			return err
+		}
+		if err := o.MarkPaid(orderPaymentID); err != nil {
+			return err
+		}
+
+	case payment.StatusRefused:
+		if err := pay.Refuse(); err != nil {
+			return err
+		}
+		if err := o.Cancel(); err != nil {
+			return err
+		}
+
+	case payment.StatusRefunded:
+		if err := pay.Refund(); err != nil {
+			return err
+		}
+		if err := o.Refund(); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("paymentcallback: unsupported status %q", cb.Status)
+	}
+
+	if err := h.paymentRepo.Save(ctx, pay); err != nil {
+		return err
+	}
+
+	if err := h.orderRepo.SaveWithOutbox(ctx, o, o.Events()); err != nil {
+		return err
+	}
+	o.ClearEvents()
+
+	return nil
+}
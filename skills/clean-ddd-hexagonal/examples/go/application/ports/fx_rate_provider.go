@@ -0,0 +1,20 @@
+// internal/application/ports/fx_rate_provider.go
+package ports
+
+import (
+	"context"
+	"time"
+
+	"myapp/internal/domain/order"
+
+	"github.com/shopspring/decimal"
+)
+
+// FXRateProvider is a driven port for looking up foreign-exchange rates,
+// consulted by Money.ConvertTo and by any handler that needs to settle a
+// line item in a currency different from the one it was quoted in.
+type FXRateProvider interface {
+	// Rate returns the multiplier that converts an amount in from into an
+	// amount in to, as of at.
+	Rate(ctx context.Context, from, to order.Currency, at time.Time) (decimal.Decimal, error)
+}
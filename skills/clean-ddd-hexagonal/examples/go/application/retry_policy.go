@@ -0,0 +1,70 @@
+// internal/application/place_order/retry_policy.go
+package placeorder
+
+import (
+	"errors"
+	"time"
+)
+
+// RetryPolicy controls how BatchHandler retries a single command after a
+// failure. Only errors the Retryable classifier accepts are retried;
+// validation errors fail fast regardless of MaxAttempts.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per command, including
+	// the first. A value of 1 disables retries.
+	MaxAttempts int
+
+	// Backoff returns the delay before the given retry attempt (1-indexed:
+	// attempt 1 is the delay before the second try). A nil Backoff retries
+	// immediately.
+	Backoff func(attempt int) time.Duration
+
+	// Retryable classifies whether err is worth retrying. Defaults to
+	// DefaultRetryable if nil.
+	Retryable func(err error) bool
+}
+
+// DefaultRetryPolicy tries a command up to three times in all (the first
+// attempt plus at most two retries of transient repository errors) with
+// linear backoff, and never retries validation errors such as
+// ErrProductNotFound.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		Backoff: func(attempt int) time.Duration {
+			return time.Duration(attempt) * 100 * time.Millisecond
+		},
+		Retryable: DefaultRetryable,
+	}
+}
+
+// DefaultRetryable retries ErrTransientRepo and any error wrapping it, and
+// treats everything else, including ErrProductNotFound, as non-retryable.
+func DefaultRetryable(err error) bool {
+	return errors.Is(err, ErrTransientRepo)
+}
+
+// maxAttempts normalizes MaxAttempts to at least 1.
+func (p RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts <= 0 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// retryable applies Retryable, falling back to DefaultRetryable if unset.
+func (p RetryPolicy) retryable(err error) bool {
+	if p.Retryable == nil {
+		return DefaultRetryable(err)
+	}
+	return p.Retryable(err)
+}
+
+// backoffFor returns the configured delay before the given attempt, or
+// zero if no Backoff was configured.
+func (p RetryPolicy) backoffFor(attempt int) time.Duration {
+	if p.Backoff == nil {
+		return 0
+	}
+	return p.Backoff(attempt)
+}
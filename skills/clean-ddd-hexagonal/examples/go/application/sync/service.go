@@ -0,0 +1,190 @@
+// internal/application/sync/service.go
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	"myapp/internal/application/ports"
+	"myapp/internal/domain/order"
+)
+
+// SyncService reconciles orders from an ExternalOrderSource into the
+// local order.Repository, mirroring the order-sync loops exchanges run
+// against a matching engine: page through external state, dedupe by ID,
+// and apply it to the local aggregate without regressing behind an
+// out-of-order or stale update.
+type SyncService struct {
+	source  string
+	orders  ExternalOrderSource
+	state   StateStore
+	repo    order.Repository
+	fxRates ports.FXRateProvider
+}
+
+// NewSyncService creates a SyncService for a single named external
+// source. source identifies the cursor row in StateStore and should be
+// stable across restarts (e.g. "warehouse-psp"). fxRates converts a line
+// item quoted in a currency other than its order's settlement currency;
+// it may be nil if the external source never reports items in a
+// different currency than the order settles in, in which case such items
+// are rejected rather than assumed to be 1:1.
+func NewSyncService(source string, orders ExternalOrderSource, state StateStore, repo order.Repository, fxRates ports.FXRateProvider) *SyncService {
+	return &SyncService{source: source, orders: orders, state: state, repo: repo, fxRates: fxRates}
+}
+
+// Sync streams every order the external source has touched since the
+// last saved cursor, applies each to the local aggregate, and advances
+// the cursor after every successfully applied order so a crash mid-run
+// resumes rather than reprocesses from scratch.
+func (s *SyncService) Sync(ctx context.Context) error {
+	cursor, err := s.state.LoadCursor(ctx, s.source)
+	if err != nil {
+		return fmt.Errorf("sync: load cursor: %w", err)
+	}
+
+	externalOrders, errs := s.orders.QueryOrdersSince(ctx, cursor.LastSyncAt, cursor.LastOrderID)
+
+	seen := make(map[string]bool)
+	for ext := range externalOrders {
+		if seen[ext.ID] {
+			continue // dedupe repeated deliveries within this page
+		}
+		seen[ext.ID] = true
+
+		if err := s.apply(ctx, ext); err != nil {
+			return fmt.Errorf("sync: apply order %s: %w", ext.ID, err)
+		}
+
+		cursor = Cursor{SourceName: s.source, LastOrderID: ext.ID, LastSyncAt: ext.UpdatedAt}
+		if err := s.state.SaveCursor(ctx, cursor); err != nil {
+			return fmt.Errorf("sync: save cursor: %w", err)
+		}
+	}
+
+	if err := <-errs; err != nil {
+		return fmt.Errorf("sync: %s: %w", s.source, err)
+	}
+	return nil
+}
+
+// apply reconciles a single external order into the local repository,
+// either constructing it for the first time or advancing its state
+// machine. It is a no-op if ext is stale relative to what is already
+// persisted, which handles external sources that redeliver or reorder
+// updates.
+func (s *SyncService) apply(ctx context.Context, ext ExternalOrder) error {
+	id, err := order.OrderIDFrom(ext.ID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		return s.createFromExternal(ctx, ext)
+	}
+
+	if ext.Version <= existing.Version() {
+		return nil
+	}
+
+	return s.transition(ctx, existing, ext)
+}
+
+// createFromExternal reconstitutes a brand-new local Order from the
+// external source's view and persists it with its OrderReconciled event
+// through the transactional outbox.
+func (s *SyncService) createFromExternal(ctx context.Context, ext ExternalOrder) error {
+	id, err := order.OrderIDFrom(ext.ID)
+	if err != nil {
+		return err
+	}
+	customerID, err := order.CustomerIDFrom(ext.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	settlementCode := ext.Currency
+	if settlementCode == "" {
+		settlementCode = order.USD.Code()
+	}
+	settlementCurrency, err := order.CurrencyFrom(settlementCode)
+	if err != nil {
+		return err
+	}
+
+	items := make([]order.OrderItem, 0, len(ext.Items))
+	for _, extItem := range ext.Items {
+		productID, err := order.ProductIDFrom(extItem.ProductID)
+		if err != nil {
+			return err
+		}
+		itemCurrency, err := order.CurrencyFrom(extItem.Currency)
+		if err != nil {
+			return err
+		}
+		unitPrice, err := order.NewMoneyFromMinor(extItem.UnitPrice, itemCurrency)
+		if err != nil {
+			return err
+		}
+
+		if itemCurrency == settlementCurrency {
+			items = append(items, order.NewOrderItem(productID, extItem.Quantity, unitPrice))
+			continue
+		}
+
+		// The item is quoted in a different currency than the order
+		// settles in; record the real conversion rate on the item rather
+		// than defaulting to an implicit (and likely wrong) 1:1 rate.
+		if s.fxRates == nil {
+			return fmt.Errorf("sync: item %s quoted in %s but order %s settles in %s with no FXRateProvider configured",
+				extItem.ProductID, itemCurrency.Code(), ext.ID, settlementCurrency.Code())
+		}
+		rate, err := s.fxRates.Rate(ctx, itemCurrency, settlementCurrency, ext.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("sync: rate for item %s (%s -> %s): %w", extItem.ProductID, itemCurrency.Code(), settlementCurrency.Code(), err)
+		}
+		items = append(items, order.NewOrderItemWithRate(productID, extItem.Quantity, unitPrice, rate))
+	}
+
+	reconstituted := order.Reconstitute(id, customerID, items, ext.Status, ext.UpdatedAt, ext.Version, settlementCurrency)
+	reconstituted.AddEvent(order.NewOrderReconciledEvent(id))
+
+	if err := s.repo.SaveWithOutbox(ctx, reconstituted, reconstituted.Events()); err != nil {
+		return err
+	}
+	reconstituted.ClearEvents()
+	return nil
+}
+
+// transition advances existing to match ext's reported status and
+// persists it, recording an OrderReconciled event regardless of which
+// domain transition fired (or whether ext's status required one at all).
+func (s *SyncService) transition(ctx context.Context, existing *order.Order, ext ExternalOrder) error {
+	switch ext.Status {
+	case order.OrderStatusConfirmed:
+		if existing.Status() == order.OrderStatusDraft {
+			if err := existing.Confirm(); err != nil {
+				return err
+			}
+		}
+	case order.OrderStatusShipped:
+		if existing.Status() != order.OrderStatusShipped {
+			if err := existing.MarkShipped(); err != nil {
+				return err
+			}
+		}
+	}
+
+	existing.AddEvent(order.NewOrderReconciledEvent(existing.ID()))
+
+	if err := s.repo.SaveWithOutbox(ctx, existing, existing.Events()); err != nil {
+		return err
+	}
+	existing.ClearEvents()
+	return nil
+}
@@ -0,0 +1,42 @@
+// internal/application/sync/source.go
+package sync
+
+import (
+	"context"
+	"time"
+
+	"myapp/internal/domain/order"
+)
+
+// ExternalOrder is the external fulfillment source's view of an order, as
+// returned by ExternalOrderSource.
+type ExternalOrder struct {
+	ID         string
+	CustomerID string
+	Items      []ExternalOrderItem
+	Status     order.OrderStatus
+	Version    int
+	UpdatedAt  time.Time
+	Currency   string // ISO 4217 settlement currency; defaults to USD if empty
+}
+
+// ExternalOrderItem is a single line item as reported by the external
+// source.
+type ExternalOrderItem struct {
+	ProductID string
+	Quantity  int
+	UnitPrice int64
+	Currency  string
+}
+
+// ExternalOrderSource is a driven port for querying order state from a
+// system, such as a warehouse or PSP, that owns fulfillment truth.
+// Results are streamed over a channel so SyncService can page through a
+// potentially large backlog without loading it all into memory at once.
+type ExternalOrderSource interface {
+	// QueryOrdersSince streams every order touched at or after since,
+	// resuming after lastID when the caller is continuing a previous
+	// page. The error channel carries at most one error; both channels
+	// are closed once the query completes or fails.
+	QueryOrdersSince(ctx context.Context, since time.Time, lastID string) (<-chan ExternalOrder, <-chan error)
+}
@@ -0,0 +1,28 @@
+// internal/application/sync/state.go
+package sync
+
+import (
+	"context"
+	"time"
+)
+
+// Cursor is the sync position for a single external source: the last
+// order processed and when. SyncService resumes from here on restart
+// instead of re-scanning the entire external history.
+type Cursor struct {
+	SourceName  string
+	LastOrderID string
+	LastSyncAt  time.Time
+}
+
+// StateStore is a driven port for persisting a source's Cursor in the
+// sync_state table, so an interrupted sync resumes rather than restarts.
+type StateStore interface {
+	// LoadCursor returns the persisted cursor for source, or the zero
+	// Cursor (with SourceName set) if none has been saved yet.
+	LoadCursor(ctx context.Context, source string) (Cursor, error)
+
+	// SaveCursor persists cursor, overwriting any previous value saved
+	// for the same SourceName.
+	SaveCursor(ctx context.Context, cursor Cursor) error
+}
@@ -54,3 +54,11 @@ func (a *AggregateRoot[ID]) ClearEvents() {
 func (a *AggregateRoot[ID]) Version() int {
 	return a.version
 }
+
+// SetVersion overrides the aggregate's version counter. This exists for
+// repositories and reconciliation code reconstituting an aggregate from
+// previously persisted state; domain logic should otherwise treat
+// Version as derived from successful Save calls, not settable directly.
+func (a *AggregateRoot[ID]) SetVersion(version int) {
+	a.version = version
+}
@@ -0,0 +1,145 @@
+// internal/domain/order/events.go
+package order
+
+import "time"
+
+// OrderShippedEvent is emitted when an Order transitions to
+// OrderStatusShipped, whether via an explicit MarkShipped call or because
+// fill tracking completed the order's last outstanding item.
+type OrderShippedEvent struct {
+	orderID    OrderID
+	occurredAt time.Time
+}
+
+// NewOrderShippedEvent creates an OrderShippedEvent for the given order.
+func NewOrderShippedEvent(orderID OrderID) OrderShippedEvent {
+	return OrderShippedEvent{orderID: orderID, occurredAt: time.Now()}
+}
+
+// EventType identifies this event for outbox storage and routing.
+func (e OrderShippedEvent) EventType() string { return "order.shipped" }
+
+// OccurredAt returns when the event was created.
+func (e OrderShippedEvent) OccurredAt() time.Time { return e.occurredAt }
+
+// OrderID returns the identifier of the order that shipped.
+func (e OrderShippedEvent) OrderID() OrderID { return e.orderID }
+
+// OrderPaidEvent is emitted when an Order transitions from
+// AwaitingPayment to Confirmed via MarkPaid.
+type OrderPaidEvent struct {
+	orderID    OrderID
+	paymentID  PaymentID
+	occurredAt time.Time
+}
+
+// NewOrderPaidEvent creates an OrderPaidEvent for the given order and payment.
+func NewOrderPaidEvent(orderID OrderID, paymentID PaymentID) OrderPaidEvent {
+	return OrderPaidEvent{orderID: orderID, paymentID: paymentID, occurredAt: time.Now()}
+}
+
+// EventType identifies this event for outbox storage and routing.
+func (e OrderPaidEvent) EventType() string { return "order.paid" }
+
+// OccurredAt returns when the event was created.
+func (e OrderPaidEvent) OccurredAt() time.Time { return e.occurredAt }
+
+// OrderID returns the identifier of the order that was paid.
+func (e OrderPaidEvent) OrderID() OrderID { return e.orderID }
+
+// PaymentID returns the identifier of the payment that paid for the order.
+func (e OrderPaidEvent) PaymentID() PaymentID { return e.paymentID }
+
+// OrderRefundedEvent is emitted when an Order transitions from Confirmed
+// to Refunded via Refund.
+type OrderRefundedEvent struct {
+	orderID    OrderID
+	occurredAt time.Time
+}
+
+// NewOrderRefundedEvent creates an OrderRefundedEvent for the given order.
+func NewOrderRefundedEvent(orderID OrderID) OrderRefundedEvent {
+	return OrderRefundedEvent{orderID: orderID, occurredAt: time.Now()}
+}
+
+// EventType identifies this event for outbox storage and routing.
+func (e OrderRefundedEvent) EventType() string { return "order.refunded" }
+
+// OccurredAt returns when the event was created.
+func (e OrderRefundedEvent) OccurredAt() time.Time { return e.occurredAt }
+
+// OrderID returns the identifier of the order that was refunded.
+func (e OrderRefundedEvent) OrderID() OrderID { return e.orderID }
+
+// OrderCancelledEvent is emitted when an Order transitions to Cancelled
+// via Cancel, whether from Draft or AwaitingPayment.
+type OrderCancelledEvent struct {
+	orderID    OrderID
+	occurredAt time.Time
+}
+
+// NewOrderCancelledEvent creates an OrderCancelledEvent for the given order.
+func NewOrderCancelledEvent(orderID OrderID) OrderCancelledEvent {
+	return OrderCancelledEvent{orderID: orderID, occurredAt: time.Now()}
+}
+
+// EventType identifies this event for outbox storage and routing.
+func (e OrderCancelledEvent) EventType() string { return "order.cancelled" }
+
+// OccurredAt returns when the event was created.
+func (e OrderCancelledEvent) OccurredAt() time.Time { return e.occurredAt }
+
+// OrderID returns the identifier of the order that was cancelled.
+func (e OrderCancelledEvent) OrderID() OrderID { return e.orderID }
+
+// OrderItemFilledEvent is emitted each time RecordFill accepts a new
+// fulfillment against one of the order's items.
+type OrderItemFilledEvent struct {
+	orderID    OrderID
+	itemID     OrderItemID
+	quantity   int
+	occurredAt time.Time
+}
+
+// NewOrderItemFilledEvent creates an OrderItemFilledEvent for the given
+// order, item, and filled quantity.
+func NewOrderItemFilledEvent(orderID OrderID, itemID OrderItemID, quantity int) OrderItemFilledEvent {
+	return OrderItemFilledEvent{orderID: orderID, itemID: itemID, quantity: quantity, occurredAt: time.Now()}
+}
+
+// EventType identifies this event for outbox storage and routing.
+func (e OrderItemFilledEvent) EventType() string { return "order.item_filled" }
+
+// OccurredAt returns when the event was created.
+func (e OrderItemFilledEvent) OccurredAt() time.Time { return e.occurredAt }
+
+// OrderID returns the identifier of the order the item belongs to.
+func (e OrderItemFilledEvent) OrderID() OrderID { return e.orderID }
+
+// ItemID returns the identifier of the item that was filled.
+func (e OrderItemFilledEvent) ItemID() OrderItemID { return e.itemID }
+
+// Quantity returns how many units this fill covered.
+func (e OrderItemFilledEvent) Quantity() int { return e.quantity }
+
+// OrderReconciledEvent is emitted by the sync subsystem once an external
+// fulfillment source's view of an order has been applied to the local
+// aggregate, whether that meant creating it or advancing its state.
+type OrderReconciledEvent struct {
+	orderID    OrderID
+	occurredAt time.Time
+}
+
+// NewOrderReconciledEvent creates an OrderReconciledEvent for the given order.
+func NewOrderReconciledEvent(orderID OrderID) OrderReconciledEvent {
+	return OrderReconciledEvent{orderID: orderID, occurredAt: time.Now()}
+}
+
+// EventType identifies this event for outbox storage and routing.
+func (e OrderReconciledEvent) EventType() string { return "order.reconciled" }
+
+// OccurredAt returns when the event was created.
+func (e OrderReconciledEvent) OccurredAt() time.Time { return e.occurredAt }
+
+// OrderID returns the identifier of the order that was reconciled.
+func (e OrderReconciledEvent) OrderID() OrderID { return e.orderID }
@@ -2,48 +2,99 @@
 package order
 
 import (
+	"context"
 	"errors"
 	"time"
 
 	"myapp/internal/domain/shared"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // OrderStatus represents the lifecycle state of an Order.
-// Orders transition through states: Draft -> Confirmed -> Shipped.
-// Cancelled is a terminal state reachable from Draft or Confirmed.
+// Orders transition through states: Draft -> AwaitingPayment -> Confirmed
+// -> Shipped. Cancelled is a terminal state reachable from Draft or
+// AwaitingPayment (e.g. a refused payment); Refunded is a terminal state
+// reachable from Confirmed.
 type OrderStatus string
 
 const (
 	// OrderStatusDraft indicates a newly created order that can be modified.
 	OrderStatusDraft OrderStatus = "draft"
 
+	// OrderStatusAwaitingPayment indicates the order has been submitted for
+	// payment authorization and is waiting on the payment provider.
+	OrderStatusAwaitingPayment OrderStatus = "awaiting_payment"
+
 	// OrderStatusConfirmed indicates the order has been finalized and paid.
 	OrderStatusConfirmed OrderStatus = "confirmed"
 
+	// OrderStatusPartiallyShipped indicates at least one item has fills
+	// recorded against it but not every item is fully filled yet.
+	OrderStatusPartiallyShipped OrderStatus = "partially_shipped"
+
 	// OrderStatusShipped indicates the order has been dispatched to the customer.
 	OrderStatusShipped OrderStatus = "shipped"
 
 	// OrderStatusCancelled indicates the order has been cancelled.
 	OrderStatusCancelled OrderStatus = "cancelled"
+
+	// OrderStatusRefunded indicates a confirmed order's payment was refunded.
+	OrderStatusRefunded OrderStatus = "refunded"
 )
 
 // OrderItem represents a line item within an Order.
 // It tracks the product, quantity, and unit price for a single product type.
+// fxRate is the rate that converts unitPrice's currency into the owning
+// Order's settlement currency, captured at the time the item was added so
+// that later rate movements do not change an already-placed order's total.
 type OrderItem struct {
+	id        OrderItemID
 	productID ProductID
 	quantity  int
 	unitPrice Money
+	fxRate    decimal.Decimal
 }
 
-// NewOrderItem creates a new order item with the specified product details.
+// NewOrderItem creates a new order item priced in the order's own
+// settlement currency, assigning it a fresh OrderItemID. Use
+// NewOrderItemWithRate for an item priced in a different currency.
 func NewOrderItem(productID ProductID, quantity int, unitPrice Money) OrderItem {
+	return newOrderItem(NewOrderItemID(), productID, quantity, unitPrice, decimal.NewFromInt(1))
+}
+
+// NewOrderItemWithRate creates a new order item whose unitPrice is
+// denominated in a different currency than the order settles in, recording
+// fxRate as the conversion rate to apply when computing the order's total.
+func NewOrderItemWithRate(productID ProductID, quantity int, unitPrice Money, fxRate decimal.Decimal) OrderItem {
+	return newOrderItem(NewOrderItemID(), productID, quantity, unitPrice, fxRate)
+}
+
+// ReconstituteOrderItem rebuilds an OrderItem with a previously persisted
+// ID and fxRate. Use this when loading an Order from a repository;
+// NewOrderItem/NewOrderItemWithRate are for items being added to an order
+// for the first time.
+func ReconstituteOrderItem(id OrderItemID, productID ProductID, quantity int, unitPrice Money, fxRate decimal.Decimal) OrderItem {
+	return newOrderItem(id, productID, quantity, unitPrice, fxRate)
+}
+
+// newOrderItem is the shared constructor behind NewOrderItem,
+// NewOrderItemWithRate, and ReconstituteOrderItem.
+func newOrderItem(id OrderItemID, productID ProductID, quantity int, unitPrice Money, fxRate decimal.Decimal) OrderItem {
 	return OrderItem{
+		id:        id,
 		productID: productID,
 		quantity:  quantity,
 		unitPrice: unitPrice,
+		fxRate:    fxRate,
 	}
 }
 
+// ID returns the stable identifier for this line item, used by
+// Order.RecordFill to track fulfillment per item.
+func (i OrderItem) ID() OrderItemID { return i.id }
+
 // ProductID returns the identifier of the product in this line item.
 func (i OrderItem) ProductID() ProductID { return i.productID }
 
@@ -53,16 +104,42 @@ func (i OrderItem) Quantity() int { return i.quantity }
 // UnitPrice returns the price per unit at the time of ordering.
 func (i OrderItem) UnitPrice() Money { return i.unitPrice }
 
-// Subtotal calculates the total price for this line item.
+// FXRate returns the rate that converts this item's unitPrice currency
+// into the owning Order's settlement currency.
+func (i OrderItem) FXRate() decimal.Decimal { return i.fxRate }
+
+// Subtotal calculates the total price for this line item, in unitPrice's
+// own currency. Use ConvertedSubtotal to express it in the order's
+// settlement currency.
 func (i OrderItem) Subtotal() Money {
 	return i.unitPrice.Multiply(i.quantity)
 }
 
+// ConvertedSubtotal returns the line item's subtotal expressed in
+// settlementCurrency, by applying the fxRate captured when the item was
+// added to the order.
+func (i OrderItem) ConvertedSubtotal(settlementCurrency Currency) (Money, error) {
+	converted := i.Subtotal().Decimal().Mul(i.fxRate)
+	return NewMoney(converted, settlementCurrency)
+}
+
 // IncreaseQuantity adds additional units to this line item.
 func (i *OrderItem) IncreaseQuantity(amount int) {
 	i.quantity += amount
 }
 
+// Fill represents a single fulfillment event recorded against an
+// OrderItem. Fills accumulate independently of the order's own status so
+// cumulative progress per item can be audited, even though the order as
+// a whole only tracks Confirmed/PartiallyShipped/Shipped.
+type Fill struct {
+	ID          string
+	ItemID      OrderItemID
+	Quantity    int
+	FilledAt    time.Time
+	ExternalRef string
+}
+
 // Order is an aggregate root representing a customer's purchase request.
 // It maintains the consistency boundary for order items, status transitions,
 // and business rules around order modifications.
@@ -76,9 +153,12 @@ type Order struct {
 	items      []OrderItem
 	status     OrderStatus
 	createdAt  time.Time
+	paymentID  PaymentID
+	fills      []Fill
+	currency   Currency
 }
 
-// NewOrder creates a new Order aggregate in Draft status.
+// NewOrder creates a new Order aggregate in Draft status, settling in USD.
 // Emits an OrderCreated domain event upon creation.
 func NewOrder(customerID CustomerID) *Order {
 	id := NewOrderID()
@@ -90,23 +170,69 @@ func NewOrder(customerID CustomerID) *Order {
 		items:      make([]OrderItem, 0),
 		status:     OrderStatusDraft,
 		createdAt:  time.Now(),
+		currency:   USD,
 	}
 	order.AddEvent(NewOrderCreatedEvent(id, customerID))
 	return order
 }
 
-// AddItem adds a product to the order or increases quantity if already present.
-// Returns an error if the order is cancelled or the quantity is not positive.
+// Reconstitute rebuilds an Order aggregate from previously persisted or
+// externally sourced state, bypassing the invariants NewOrder enforces
+// for brand-new orders (in particular, it does not emit OrderCreated).
+// Use this from a repository loading an aggregate, or from reconciliation
+// code building a local Order to mirror an external system's record.
+func Reconstitute(id OrderID, customerID CustomerID, items []OrderItem, status OrderStatus, createdAt time.Time, version int, currency Currency) *Order {
+	o := &Order{
+		AggregateRoot: shared.AggregateRoot[OrderID]{
+			Entity: shared.NewEntity(id),
+		},
+		customerID: customerID,
+		items:      items,
+		status:     status,
+		createdAt:  createdAt,
+		currency:   currency,
+	}
+	o.SetVersion(version)
+	return o
+}
+
+// WithFills attaches previously persisted Fill records to a reconstituted
+// Order and returns o for chaining. It exists for repositories loading an
+// aggregate's fulfillment history; once constructed, fills should only be
+// added through RecordFill.
+func (o *Order) WithFills(fills []Fill) *Order {
+	o.fills = fills
+	return o
+}
+
+// AddItem adds a product priced in the order's own settlement currency to
+// the order, or increases quantity if already present. Returns an error if
+// the order is cancelled or the quantity is not positive.
 //
 // This method enforces the invariant that cancelled orders cannot be modified
 // and demonstrates idempotent handling of duplicate product additions.
 func (o *Order) AddItem(productID ProductID, quantity int, unitPrice Money) error {
+	return o.addItem(productID, quantity, unitPrice, decimal.NewFromInt(1))
+}
+
+// AddItemWithRate adds a product priced in a different currency than the
+// order settles in, recording fxRate as the conversion rate to apply when
+// computing the order's total. Returns an error if the order is cancelled
+// or the quantity is not positive.
+func (o *Order) AddItemWithRate(productID ProductID, quantity int, unitPrice Money, fxRate decimal.Decimal) error {
+	return o.addItem(productID, quantity, unitPrice, fxRate)
+}
+
+func (o *Order) addItem(productID ProductID, quantity int, unitPrice Money, fxRate decimal.Decimal) error {
 	if o.status == OrderStatusCancelled {
 		return errors.New("cannot modify cancelled order")
 	}
 	if quantity <= 0 {
 		return errors.New("quantity must be positive")
 	}
+	if len(o.fills) > 0 {
+		return errors.New("cannot modify order once fulfillment has started")
+	}
 
 	// Check if item already exists - merge quantities
 	for i := range o.items {
@@ -116,7 +242,7 @@ func (o *Order) AddItem(productID ProductID, quantity int, unitPrice Money) erro
 		}
 	}
 
-	item := NewOrderItem(productID, quantity, unitPrice)
+	item := newOrderItem(NewOrderItemID(), productID, quantity, unitPrice, fxRate)
 	o.items = append(o.items, item)
 	return nil
 }
@@ -132,22 +258,207 @@ func (o *Order) Confirm() error {
 		return errors.New("cannot confirm empty order")
 	}
 
+	// No cross-currency conversion is needed to confirm an order in its
+	// own settlement currency, so provider is never consulted here.
+	total, err := o.Total(context.Background(), nil, o.currency)
+	if err != nil {
+		return err
+	}
+
 	o.status = OrderStatusConfirmed
-	o.AddEvent(NewOrderConfirmedEvent(o.ID(), o.Total()))
+	o.AddEvent(NewOrderConfirmedEvent(o.ID(), total))
 	return nil
 }
 
-// Total calculates the sum of all line item subtotals.
-// Returns a Money value in USD (default currency).
-func (o *Order) Total() Money {
-	total := Money{amount: 0, currency: "USD"}
-	for _, item := range o.items {
-		subtotal := item.Subtotal()
-		total, _ = total.Add(subtotal)
+// AwaitPayment transitions the order from Draft to AwaitingPayment status.
+// Returns an error if the order is not in Draft status or has no items.
+// Use this once a payment has been submitted for authorization, before
+// the provider has confirmed it; MarkPaid completes the transition once
+// authorization succeeds.
+func (o *Order) AwaitPayment() error {
+	if o.status != OrderStatusDraft {
+		return errors.New("can only await payment from draft orders")
+	}
+	if len(o.items) == 0 {
+		return errors.New("cannot await payment for an empty order")
+	}
+
+	o.status = OrderStatusAwaitingPayment
+	return nil
+}
+
+// MarkPaid transitions the order from AwaitingPayment to Confirmed status
+// and records which Payment aggregate paid for it. Returns an error if
+// the order is not AwaitingPayment. Emits an OrderPaid domain event on
+// success.
+func (o *Order) MarkPaid(paymentID PaymentID) error {
+	if o.status != OrderStatusAwaitingPayment {
+		return errors.New("can only mark awaiting-payment orders as paid")
+	}
+
+	o.paymentID = paymentID
+	o.status = OrderStatusConfirmed
+	o.AddEvent(NewOrderPaidEvent(o.ID(), paymentID))
+	return nil
+}
+
+// Cancel transitions the order to Cancelled status from Draft or
+// AwaitingPayment. Returns an error if the order has already been
+// confirmed or reached a terminal state. Use this when a payment
+// authorization is refused, or a draft order is abandoned. Emits an
+// OrderCancelledEvent domain event on success.
+func (o *Order) Cancel() error {
+	if o.status != OrderStatusDraft && o.status != OrderStatusAwaitingPayment {
+		return errors.New("can only cancel draft or awaiting-payment orders")
+	}
+
+	o.status = OrderStatusCancelled
+	o.AddEvent(NewOrderCancelledEvent(o.ID()))
+	return nil
+}
+
+// Refund transitions the order from Confirmed to Refunded status.
+// Returns an error if the order is not Confirmed. Emits an OrderRefunded
+// domain event on success; the caller is responsible for refunding the
+// associated Payment through ports.PaymentGateway.
+func (o *Order) Refund() error {
+	if o.status != OrderStatusConfirmed {
+		return errors.New("can only refund confirmed orders")
+	}
+
+	o.status = OrderStatusRefunded
+	o.AddEvent(NewOrderRefundedEvent(o.ID()))
+	return nil
+}
+
+// PaymentID returns the identifier of the Payment that paid for this
+// order, or the zero PaymentID if none has been recorded yet.
+func (o *Order) PaymentID() PaymentID { return o.paymentID }
+
+// MarkShipped transitions the order from Confirmed to Shipped status.
+// Returns an error if the order is not Confirmed. Emits an OrderShipped
+// domain event on success.
+//
+// This is the transition reconciliation code calls when an external
+// fulfillment source reports an order has left the warehouse; in-process
+// fulfillment should generally reach OrderStatusShipped via fill tracking
+// instead.
+func (o *Order) MarkShipped() error {
+	if o.status != OrderStatusConfirmed {
+		return errors.New("can only mark confirmed orders as shipped")
+	}
+	o.status = OrderStatusShipped
+	o.AddEvent(NewOrderShippedEvent(o.ID()))
+	return nil
+}
+
+// RecordFill records that qty units of the item identified by itemID have
+// been fulfilled, as reported by a warehouse/PSP identified by ref.
+// Returns an error if the order is not Confirmed or PartiallyShipped, if
+// the order has no such item, or if qty would push the item's cumulative
+// filled quantity past what was ordered. Emits an OrderItemFilled event,
+// and transitions the order to OrderStatusPartiallyShipped once any item
+// has fills, or to OrderStatusShipped (emitting OrderShipped) once every
+// item is fully filled.
+func (o *Order) RecordFill(itemID OrderItemID, qty int, ref string) error {
+	if o.status != OrderStatusConfirmed && o.status != OrderStatusPartiallyShipped {
+		return errors.New("can only record fills against confirmed or partially shipped orders")
+	}
+	if qty <= 0 {
+		return errors.New("fill quantity must be positive")
+	}
+
+	item := o.findItem(itemID)
+	if item == nil {
+		return errors.New("order has no such item")
+	}
+	if o.filledQuantity(itemID)+qty > item.Quantity() {
+		return errors.New("fill exceeds ordered quantity")
+	}
+
+	o.fills = append(o.fills, Fill{
+		ID:          uuid.New().String(),
+		ItemID:      itemID,
+		Quantity:    qty,
+		FilledAt:    time.Now(),
+		ExternalRef: ref,
+	})
+	o.AddEvent(NewOrderItemFilledEvent(o.ID(), itemID, qty))
+
+	switch {
+	case o.fullyFilled():
+		o.status = OrderStatusShipped
+		o.AddEvent(NewOrderShippedEvent(o.ID()))
+	case o.status == OrderStatusConfirmed:
+		o.status = OrderStatusPartiallyShipped
+	}
+
+	return nil
+}
+
+// findItem returns a pointer to the item with the given ID, or nil if no
+// such item exists on the order.
+func (o *Order) findItem(itemID OrderItemID) *OrderItem {
+	for i := range o.items {
+		if o.items[i].ID() == itemID {
+			return &o.items[i]
+		}
+	}
+	return nil
+}
+
+// filledQuantity sums the quantity already recorded against itemID.
+func (o *Order) filledQuantity(itemID OrderItemID) int {
+	total := 0
+	for _, fill := range o.fills {
+		if fill.ItemID == itemID {
+			total += fill.Quantity
+		}
 	}
 	return total
 }
 
+// fullyFilled reports whether every item's cumulative filled quantity has
+// reached its ordered quantity.
+func (o *Order) fullyFilled() bool {
+	for _, item := range o.items {
+		if o.filledQuantity(item.ID()) < item.Quantity() {
+			return false
+		}
+	}
+	return true
+}
+
+// Fills returns a copy of all fulfillment events recorded against this order.
+func (o *Order) Fills() []Fill { return append([]Fill(nil), o.fills...) }
+
+// Total calculates the sum of all line item subtotals, converting each
+// into the order's settlement currency via its recorded fxRate, then
+// converts that sum into currency via provider if currency differs from
+// the order's settlement currency - e.g. for displaying an order's total
+// in a customer's preferred currency. provider is typically a
+// ports.FXRateProvider; it may be nil when currency matches the order's
+// settlement currency, since ConvertTo does not consult it in that case.
+// Returns an error if the per-item sum cannot form valid Money (it cannot
+// be negative since items reject negative quantities and prices), or if
+// the final conversion fails.
+func (o *Order) Total(ctx context.Context, provider fxRateProvider, currency Currency) (Money, error) {
+	total := decimal.Zero
+	for _, item := range o.items {
+		subtotal := item.Subtotal().Decimal().Mul(item.FXRate())
+		total = total.Add(subtotal)
+	}
+
+	settled, err := NewMoney(total, o.currency)
+	if err != nil {
+		return Money{}, err
+	}
+	return settled.ConvertTo(ctx, provider, currency)
+}
+
+// Currency returns the currency the order settles in.
+func (o *Order) Currency() Currency { return o.currency }
+
 // Status returns the current lifecycle state of the order.
 func (o *Order) Status() OrderStatus { return o.status }
 
@@ -0,0 +1,104 @@
+// internal/domain/payment/payment.go
+package payment
+
+import (
+	"errors"
+	"time"
+
+	"myapp/internal/domain/order"
+	"myapp/internal/domain/shared"
+)
+
+// Status represents the lifecycle state of a Payment as reported by the
+// payment provider.
+type Status string
+
+const (
+	// StatusOpen indicates a payment has been authorized with the
+	// provider but not yet confirmed or refused.
+	StatusOpen Status = "open"
+
+	// StatusApproved indicates the provider approved the payment.
+	StatusApproved Status = "approved"
+
+	// StatusRefused indicates the provider refused the payment.
+	StatusRefused Status = "refused"
+
+	// StatusRefunded indicates a previously approved payment was refunded.
+	StatusRefunded Status = "refunded"
+)
+
+// Payment is an aggregate root tracking the lifecycle of a single payment
+// attempt against an Order. Payment is deliberately its own bounded
+// context, separate from the Order aggregate, so that payment truth
+// (provider state, approvals, refunds) does not leak into Order; Order
+// holds only a PaymentID reference.
+type Payment struct {
+	shared.AggregateRoot[PaymentID]
+	orderID   order.OrderID
+	amount    order.Money
+	provider  Provider
+	status    Status
+	createdAt time.Time
+}
+
+// NewPayment creates a new Payment in the Open status for orderID, for
+// amount authorized through provider.
+func NewPayment(orderID order.OrderID, amount order.Money, provider Provider) *Payment {
+	id := NewPaymentID()
+	return &Payment{
+		AggregateRoot: shared.AggregateRoot[PaymentID]{
+			Entity: shared.NewEntity(id),
+		},
+		orderID:   orderID,
+		amount:    amount,
+		provider:  provider,
+		status:    StatusOpen,
+		createdAt: time.Now(),
+	}
+}
+
+// Approve transitions the payment from Open to Approved status.
+// Returns an error if the payment is not Open.
+func (p *Payment) Approve() error {
+	if p.status != StatusOpen {
+		return errors.New("can only approve open payments")
+	}
+	p.status = StatusApproved
+	return nil
+}
+
+// Refuse transitions the payment from Open to Refused status.
+// Returns an error if the payment is not Open.
+func (p *Payment) Refuse() error {
+	if p.status != StatusOpen {
+		return errors.New("can only refuse open payments")
+	}
+	p.status = StatusRefused
+	return nil
+}
+
+// Refund transitions the payment from Approved to Refunded status.
+// Returns an error if the payment is not Approved.
+func (p *Payment) Refund() error {
+	if p.status != StatusApproved {
+		return errors.New("can only refund approved payments")
+	}
+	p.status = StatusRefunded
+	return nil
+}
+
+// OrderID returns the identifier of the order this payment is for.
+func (p *Payment) OrderID() order.OrderID { return p.orderID }
+
+// Amount returns the authorized amount.
+func (p *Payment) Amount() order.Money { return p.amount }
+
+// Provider returns the payment provider and its reference for this payment.
+func (p *Payment) Provider() Provider { return p.provider }
+
+// Status returns the current lifecycle state of the payment.
+func (p *Payment) Status() Status { return p.status }
+
+// CreatedAt returns the timestamp when the payment was created.
+func (p *Payment) CreatedAt() time.Time { return p.createdAt }
@@ -0,0 +1,16 @@
+// internal/domain/payment/repository.go
+package payment
+
+import "context"
+
+// Repository defines the persistence contract for Payment aggregates.
+// This is a driven port (secondary port) in hexagonal architecture terms,
+// allowing the domain to remain independent of persistence implementation.
+type Repository interface {
+	// FindByID retrieves a Payment by its unique identifier.
+	// Returns nil, nil if no payment exists with the given ID.
+	FindByID(ctx context.Context, id PaymentID) (*Payment, error)
+
+	// Save persists a Payment aggregate, creating or updating as needed.
+	Save(ctx context.Context, payment *Payment) error
+}
@@ -0,0 +1,56 @@
+// internal/domain/payment/value_objects.go
+package payment
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// PaymentID is a value object representing the unique identifier for a
+// Payment.
+type PaymentID struct {
+	value string
+}
+
+// NewPaymentID generates a new unique PaymentID using UUID v4.
+func NewPaymentID() PaymentID {
+	return PaymentID{value: uuid.New().String()}
+}
+
+// PaymentIDFrom creates a PaymentID from an existing string value.
+// Returns an error if the provided value is empty.
+func PaymentIDFrom(value string) (PaymentID, error) {
+	if value == "" {
+		return PaymentID{}, errors.New("payment ID cannot be empty")
+	}
+	return PaymentID{value: value}, nil
+}
+
+// String returns the string representation of the PaymentID.
+func (id PaymentID) String() string {
+	return id.value
+}
+
+// Provider is a value object identifying which payment provider processed
+// a Payment (e.g. "stripe") together with that provider's own reference
+// for it, so provider webhooks can be correlated back to a Payment.
+type Provider struct {
+	name      string
+	reference string
+}
+
+// NewProvider creates a Provider. Returns an error if name is empty.
+func NewProvider(name, reference string) (Provider, error) {
+	if name == "" {
+		return Provider{}, errors.New("provider name cannot be empty")
+	}
+	return Provider{name: name, reference: reference}, nil
+}
+
+// Name returns the provider's identifier, e.g. "stripe" or "adyen".
+func (p Provider) Name() string { return p.name }
+
+// Reference returns the provider's own identifier for this payment, as
+// returned by ports.PaymentGateway.Authorize.
+func (p Provider) Reference() string { return p.reference }
@@ -1,7 +1,11 @@
 // internal/domain/order/repository.go
 package order
 
-import "context"
+import (
+	"context"
+
+	"myapp/internal/domain/shared"
+)
 
 // Repository defines the persistence contract for Order aggregates.
 // This is a driven port (secondary port) in hexagonal architecture terms,
@@ -20,6 +24,12 @@ type Repository interface {
 	// aggregate's version field.
 	Save(ctx context.Context, order *Order) error
 
+	// SaveWithOutbox persists an Order aggregate and writes events to the
+	// transactional outbox within the same database transaction, so
+	// neither write is observable without the other. Callers should pass
+	// order.Events() and clear them once this returns successfully.
+	SaveWithOutbox(ctx context.Context, order *Order, events []shared.DomainEvent) error
+
 	// Delete removes an Order from the persistence store.
 	// Returns an error if the deletion fails.
 	Delete(ctx context.Context, order *Order) error
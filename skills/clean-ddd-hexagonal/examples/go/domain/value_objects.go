@@ -2,9 +2,13 @@
 package order
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // OrderID is a value object representing the unique identifier for an Order.
@@ -74,43 +78,235 @@ func (id ProductID) String() string {
 	return id.value
 }
 
-// Money is a value object representing a monetary amount with currency.
-// It ensures monetary calculations maintain precision by storing amounts
-// in the smallest currency unit (e.g., cents for USD).
+// PaymentID is a value object referencing the payment aggregate
+// associated with an Order. Order holds only this identifier, never the
+// payment aggregate itself, so the order and payment bounded contexts
+// stay decoupled from one another.
+type PaymentID struct {
+	value string
+}
+
+// PaymentIDFrom creates a PaymentID from an existing string value.
+// Returns an error if the provided value is empty.
+func PaymentIDFrom(value string) (PaymentID, error) {
+	if value == "" {
+		return PaymentID{}, errors.New("payment ID cannot be empty")
+	}
+	return PaymentID{value: value}, nil
+}
+
+// String returns the string representation of the PaymentID.
+func (id PaymentID) String() string {
+	return id.value
+}
+
+// OrderItemID is a value object identifying a single line item within an
+// Order. It gives fill tracking something stable to reference even
+// though AddItem merges quantities into an existing item for duplicate
+// products rather than appending a new one.
+type OrderItemID struct {
+	value string
+}
+
+// NewOrderItemID generates a new unique OrderItemID using UUID v4.
+func NewOrderItemID() OrderItemID {
+	return OrderItemID{value: uuid.New().String()}
+}
+
+// OrderItemIDFrom creates an OrderItemID from an existing string value.
+// Returns an error if the provided value is empty.
+func OrderItemIDFrom(value string) (OrderItemID, error) {
+	if value == "" {
+		return OrderItemID{}, errors.New("order item ID cannot be empty")
+	}
+	return OrderItemID{value: value}, nil
+}
+
+// String returns the string representation of the OrderItemID.
+func (id OrderItemID) String() string {
+	return id.value
+}
+
+// Currency is a value object identifying a monetary unit by its ISO 4217
+// code and the number of decimal places its minor unit is quoted in (e.g.
+// USD has exponent 2 because 1 USD = 100 cents, JPY has exponent 0).
+type Currency struct {
+	code     string
+	exponent int32
+}
+
+// currencyExponents holds the handful of currencies this example
+// supports. A production system would source this from a proper ISO 4217
+// table instead of a hardcoded map.
+var currencyExponents = map[string]int32{
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"JPY": 0,
+}
+
+// CurrencyFrom creates a Currency for an ISO 4217 code.
+// Returns an error if the code is not recognized.
+func CurrencyFrom(code string) (Currency, error) {
+	exponent, ok := currencyExponents[code]
+	if !ok {
+		return Currency{}, fmt.Errorf("unknown currency code %q", code)
+	}
+	return Currency{code: code, exponent: exponent}, nil
+}
+
+// Code returns the ISO 4217 currency code.
+func (c Currency) Code() string { return c.code }
+
+// Exponent returns the number of decimal places this currency's minor
+// unit is quoted in.
+func (c Currency) Exponent() int32 { return c.exponent }
+
+// USD is the default settlement currency for orders that do not specify
+// one explicitly.
+var USD = Currency{code: "USD", exponent: 2}
+
+// Money is a value object representing a monetary amount in a single
+// currency. Amounts are stored as an arbitrary-precision decimal rather
+// than integer minor units, so tax rates, FX rates, and multi-currency
+// subtotals can be represented without the silent overflow or rounding
+// loss of scaling int64 cents.
 //
 // Money is immutable; all operations return new Money instances.
 type Money struct {
-	amount   int64  // Amount in smallest currency unit (e.g., cents)
-	currency string // ISO 4217 currency code (e.g., "USD")
+	amount   decimal.Decimal
+	currency Currency
 }
 
-// NewMoney creates a Money value object with the specified amount and currency.
-// The amount should be in the smallest currency unit (e.g., cents).
-// Returns an error if the amount is negative.
-func NewMoney(amount int64, currency string) (Money, error) {
-	if amount < 0 {
+// NewMoney creates a Money value object for the given decimal amount and
+// currency. Returns an error if amount is negative.
+func NewMoney(amount decimal.Decimal, currency Currency) (Money, error) {
+	if amount.IsNegative() {
 		return Money{}, errors.New("amount cannot be negative")
 	}
 	return Money{amount: amount, currency: currency}, nil
 }
 
+// NewMoneyFromMinor creates Money from an integer amount in the
+// currency's minor unit (e.g. cents), the representation persisted by
+// the postgres repository's amount_minor column. Returns an error if
+// minor is negative.
+func NewMoneyFromMinor(minor int64, currency Currency) (Money, error) {
+	if minor < 0 {
+		return Money{}, errors.New("amount cannot be negative")
+	}
+	scale := decimal.New(1, currency.exponent)
+	return Money{amount: decimal.NewFromInt(minor).Div(scale), currency: currency}, nil
+}
+
 // Add combines two Money values of the same currency.
 // Returns an error if the currencies do not match.
 func (m Money) Add(other Money) (Money, error) {
 	if m.currency != other.currency {
 		return Money{}, errors.New("currency mismatch")
 	}
-	return Money{amount: m.amount + other.amount, currency: m.currency}, nil
+	return Money{amount: m.amount.Add(other.amount), currency: m.currency}, nil
+}
+
+// Sub subtracts other from m. Both must be in the same currency.
+// Returns an error if the currencies do not match.
+func (m Money) Sub(other Money) (Money, error) {
+	if m.currency != other.currency {
+		return Money{}, errors.New("currency mismatch")
+	}
+	return Money{amount: m.amount.Sub(other.amount), currency: m.currency}, nil
 }
 
 // Multiply scales the Money amount by an integer factor.
 // Returns a new Money instance with the multiplied amount.
 func (m Money) Multiply(factor int) Money {
-	return Money{amount: m.amount * int64(factor), currency: m.currency}
+	return Money{amount: m.amount.Mul(decimal.NewFromInt(int64(factor))), currency: m.currency}
+}
+
+// MultiplyDecimal scales the Money amount by an arbitrary-precision
+// factor, e.g. a tax rate such as decimal.NewFromFloat(0.0825).
+func (m Money) MultiplyDecimal(factor decimal.Decimal) Money {
+	return Money{amount: m.amount.Mul(factor), currency: m.currency}
+}
+
+// RoundingMode selects how Divide distributes the remainder left over
+// when m does not split evenly into n shares.
+type RoundingMode int
+
+const (
+	// RoundBankers rounds m to the currency's minor unit using
+	// round-half-to-even, splits that whole number of minor units evenly,
+	// and hands the leftover minor units one each to the first shares
+	// (largest-remainder allocation, where every share has the same
+	// remainder), so the shares always sum back to the rounded m.
+	RoundBankers RoundingMode = iota
+)
+
+// Divide splits m into n shares quoted in the currency's minor unit,
+// using mode to round, so that summing the returned shares always equals
+// m rounded to the minor unit - which is m itself whenever m is already a
+// whole number of minor units. E.g. splitting $10.00 three ways yields
+// $3.34, $3.33, $3.33, never three values that sum to $9.99 or $10.02.
+// Any precision in m below the minor unit is not carried into the shares.
+// Returns nil if n is not positive.
+func (m Money) Divide(n int, mode RoundingMode) []Money {
+	if n <= 0 {
+		return nil
+	}
+
+	scale := decimal.New(1, m.currency.exponent)
+	totalMinor := m.amount.Mul(scale).RoundBank(0).IntPart()
+
+	base := totalMinor / int64(n)
+	remainder := totalMinor % int64(n)
+
+	shares := make([]Money, n)
+	for i := 0; i < n; i++ {
+		minor := base
+		if int64(i) < remainder {
+			// The first `remainder` shares absorb the leftover minor unit
+			// that base*n could not account for.
+			minor++
+		}
+		shares[i] = Money{amount: decimal.NewFromInt(minor).Div(scale), currency: m.currency}
+	}
+	return shares
+}
+
+// fxRateProvider is the subset of ports.FXRateProvider that ConvertTo
+// needs. It is declared here, rather than imported, so this package does
+// not depend on the application layer; any ports.FXRateProvider
+// implementation satisfies it.
+type fxRateProvider interface {
+	Rate(ctx context.Context, from, to Currency, at time.Time) (decimal.Decimal, error)
+}
+
+// ConvertTo converts m into currency using provider's rate as of now.
+// Returns m unchanged if it is already in currency, without consulting
+// provider. provider is typically a ports.FXRateProvider.
+func (m Money) ConvertTo(ctx context.Context, provider fxRateProvider, currency Currency) (Money, error) {
+	if m.currency == currency {
+		return m, nil
+	}
+
+	rate, err := provider.Rate(ctx, m.currency, currency, time.Now())
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.amount.Mul(rate), currency: currency}, nil
+}
+
+// MinorUnits returns the amount rounded to the currency's minor unit
+// (e.g. cents), the representation the postgres repository persists in
+// its amount_minor column.
+func (m Money) MinorUnits() int64 {
+	scale := decimal.New(1, m.currency.exponent)
+	return m.amount.Mul(scale).RoundBank(0).IntPart()
 }
 
-// Amount returns the monetary amount in the smallest currency unit.
-func (m Money) Amount() int64 { return m.amount }
+// Decimal returns the exact decimal amount, for persistence in the
+// postgres repository's optional amount_decimal column.
+func (m Money) Decimal() decimal.Decimal { return m.amount }
 
-// Currency returns the ISO 4217 currency code.
-func (m Money) Currency() string { return m.currency }
+// Currency returns the currency this amount is denominated in.
+func (m Money) Currency() Currency { return m.currency }
@@ -7,20 +7,25 @@ import (
 	"context"
 	"database/sql"
 
+	"myapp/internal/application/outbox"
 	"myapp/internal/domain/order"
+	"myapp/internal/domain/shared"
 )
 
 // OrderRepository is a PostgreSQL implementation of order.Repository.
 // It handles persistence of Order aggregates using SQL transactions
 // to maintain consistency between orders and their line items.
 type OrderRepository struct {
-	db *sql.DB
+	db          *sql.DB
+	outboxStore outbox.Store
+	codec       outbox.DomainEventCodec
 }
 
-// NewOrderRepository creates an OrderRepository with the given database connection.
-// The caller is responsible for managing the connection lifecycle.
-func NewOrderRepository(db *sql.DB) *OrderRepository {
-	return &OrderRepository{db: db}
+// NewOrderRepository creates an OrderRepository with the given database
+// connection, outbox store, and domain event codec. The caller is
+// responsible for managing the connection lifecycle.
+func NewOrderRepository(db *sql.DB, outboxStore outbox.Store, codec outbox.DomainEventCodec) *OrderRepository {
+	return &OrderRepository{db: db, outboxStore: outboxStore, codec: codec}
 }
 
 // FindByID retrieves an Order aggregate by its identifier.
@@ -30,11 +35,11 @@ func NewOrderRepository(db *sql.DB) *OrderRepository {
 // This method reconstitutes the full aggregate including all line items.
 func (r *OrderRepository) FindByID(ctx context.Context, id order.OrderID) (*order.Order, error) {
 	row := r.db.QueryRowContext(ctx,
-		`SELECT id, customer_id, status, created_at, version
+		`SELECT id, customer_id, status, created_at, version, payment_id, currency
          FROM orders WHERE id = $1`, id.String())
 
 	var o orderRow
-	if err := row.Scan(&o.ID, &o.CustomerID, &o.Status, &o.CreatedAt, &o.Version); err != nil {
+	if err := row.Scan(&o.ID, &o.CustomerID, &o.Status, &o.CreatedAt, &o.Version, &o.PaymentID, &o.Currency); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -46,7 +51,12 @@ func (r *OrderRepository) FindByID(ctx context.Context, id order.OrderID) (*orde
 		return nil, err
 	}
 
-	return mapToDomain(o, items), nil
+	fills, err := r.loadFills(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapToDomain(o, items, fills), nil
 }
 
 // Save persists an Order aggregate using an upsert strategy.
@@ -62,13 +72,56 @@ func (r *OrderRepository) Save(ctx context.Context, o *order.Order) error {
 	}
 	defer tx.Rollback()
 
-	_, err = tx.ExecContext(ctx,
-		`INSERT INTO orders (id, customer_id, status, created_at, version)
-         VALUES ($1, $2, $3, $4, $5)
+	if err := r.saveOrder(ctx, tx, o); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SaveWithOutbox persists o and writes events to the outbox within the
+// same transaction, giving the caller an atomic alternative to calling
+// Save and then publishing events separately. events is typically
+// o.Events(); the caller should clear them once this returns successfully.
+func (r *OrderRepository) SaveWithOutbox(ctx context.Context, o *order.Order, events []shared.DomainEvent) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := r.saveOrder(ctx, tx, o); err != nil {
+		return err
+	}
+
+	encoded := make([]outbox.EncodedEvent, 0, len(events))
+	for _, event := range events {
+		e, err := r.codec.Encode(event)
+		if err != nil {
+			return err
+		}
+		encoded = append(encoded, e)
+	}
+
+	if err := r.outboxStore.Save(ctx, tx, encoded); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// saveOrder upserts the order row and its line items within tx. It is
+// shared by Save and SaveWithOutbox so both commit the identical order
+// state, differing only in whether outbox rows are written alongside it.
+func (r *OrderRepository) saveOrder(ctx context.Context, tx *sql.Tx, o *order.Order) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO orders (id, customer_id, status, created_at, version, payment_id, currency)
+         VALUES ($1, $2, $3, $4, $5, $6, $7)
          ON CONFLICT (id) DO UPDATE SET
            status = EXCLUDED.status,
-           version = orders.version + 1`,
-		o.ID().String(), o.CustomerID().String(), o.Status(), o.CreatedAt(), o.Version())
+           version = orders.version + 1,
+           payment_id = EXCLUDED.payment_id`,
+		o.ID().String(), o.CustomerID().String(), o.Status(), o.CreatedAt(), o.Version(), o.PaymentID().String(), o.Currency().Code())
 
 	if err != nil {
 		return err
@@ -80,7 +133,13 @@ func (r *OrderRepository) Save(ctx context.Context, o *order.Order) error {
 		}
 	}
 
-	return tx.Commit()
+	for _, fill := range o.Fills() {
+		if err := r.saveFill(ctx, tx, o.ID(), fill); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Delete removes an Order from the database.
@@ -93,7 +152,7 @@ func (r *OrderRepository) Delete(ctx context.Context, o *order.Order) error {
 // loadItems retrieves all line items for an order.
 func (r *OrderRepository) loadItems(ctx context.Context, orderID order.OrderID) ([]orderItemRow, error) {
 	rows, err := r.db.QueryContext(ctx,
-		`SELECT id, product_id, quantity, unit_price
+		`SELECT id, product_id, quantity, amount_minor, currency, amount_decimal, fx_rate
          FROM order_items WHERE order_id = $1`, orderID.String())
 	if err != nil {
 		return nil, err
@@ -103,7 +162,7 @@ func (r *OrderRepository) loadItems(ctx context.Context, orderID order.OrderID)
 	var items []orderItemRow
 	for rows.Next() {
 		var item orderItemRow
-		if err := rows.Scan(&item.ID, &item.ProductID, &item.Quantity, &item.UnitPrice); err != nil {
+		if err := rows.Scan(&item.ID, &item.ProductID, &item.Quantity, &item.AmountMinor, &item.Currency, &item.AmountDecimal, &item.FXRate); err != nil {
 			return nil, err
 		}
 		items = append(items, item)
@@ -112,13 +171,56 @@ func (r *OrderRepository) loadItems(ctx context.Context, orderID order.OrderID)
 }
 
 // saveItem persists a single order item within the provided transaction.
+//
+// unit_price is stored three ways: amount_minor (the integer minor-unit
+// value, e.g. cents) for cheap exact comparisons and indexing,
+// amount_decimal (NUMERIC(38,18)) for the full-precision value Money
+// actually carries in memory, and currency/fx_rate to reconstitute the
+// item without losing the rate captured at order time. amount_minor is
+// derivable from amount_decimal but is kept alongside it as a migration
+// path from the pre-decimal schema: existing queries and reports built
+// against the old integer column keep working unchanged.
 func (r *OrderRepository) saveItem(ctx context.Context, tx *sql.Tx, orderID order.OrderID, item order.OrderItem) error {
 	_, err := tx.ExecContext(ctx,
-		`INSERT INTO order_items (id, order_id, product_id, quantity, unit_price)
-         VALUES ($1, $2, $3, $4, $5)
+		`INSERT INTO order_items (id, order_id, product_id, quantity, amount_minor, currency, amount_decimal, fx_rate)
+         VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
          ON CONFLICT (id) DO UPDATE SET
            quantity = EXCLUDED.quantity`,
-		item.ID().String(), orderID.String(), item.ProductID().String(), item.Quantity(), item.UnitPrice().Amount())
+		item.ID().String(), orderID.String(), item.ProductID().String(), item.Quantity(),
+		item.UnitPrice().MinorUnits(), item.UnitPrice().Currency().Code(), item.UnitPrice().Decimal().String(), item.FXRate().String())
+	return err
+}
+
+// loadFills retrieves all recorded fills for an order.
+func (r *OrderRepository) loadFills(ctx context.Context, orderID order.OrderID) ([]fillRow, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, item_id, quantity, filled_at, external_ref
+         FROM order_fills WHERE order_id = $1`, orderID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fills []fillRow
+	for rows.Next() {
+		var fill fillRow
+		if err := rows.Scan(&fill.ID, &fill.ItemID, &fill.Quantity, &fill.FilledAt, &fill.ExternalRef); err != nil {
+			return nil, err
+		}
+		fills = append(fills, fill)
+	}
+	return fills, rows.Err()
+}
+
+// saveFill persists a single fill record within the provided transaction.
+// Fills are append-only, so unlike saveItem/saveOrder there is no update
+// clause: a given fill ID is written once and never changes.
+func (r *OrderRepository) saveFill(ctx context.Context, tx *sql.Tx, orderID order.OrderID, fill order.Fill) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO order_fills (id, order_id, item_id, quantity, filled_at, external_ref)
+         VALUES ($1, $2, $3, $4, $5, $6)
+         ON CONFLICT (id) DO NOTHING`,
+		fill.ID, orderID.String(), fill.ItemID.String(), fill.Quantity, fill.FilledAt, fill.ExternalRef)
 	return err
 }
 
@@ -129,20 +231,36 @@ type orderRow struct {
 	Status     string
 	CreatedAt  string
 	Version    int
+	PaymentID  string
+	Currency   string
 }
 
 // orderItemRow represents the database schema for order line items.
+// unit_price is split across amount_minor, currency, and amount_decimal;
+// see saveItem for why all three are persisted.
 type orderItemRow struct {
-	ID        string
-	ProductID string
-	Quantity  int
-	UnitPrice int64
+	ID            string
+	ProductID     string
+	Quantity      int
+	AmountMinor   int64
+	Currency      string
+	AmountDecimal string
+	FXRate        string
+}
+
+// fillRow represents the database schema for order_fills.
+type fillRow struct {
+	ID          string
+	ItemID      string
+	Quantity    int
+	FilledAt    string
+	ExternalRef string
 }
 
 // mapToDomain converts database rows to the Order aggregate.
 // This function handles the impedance mismatch between the relational
 // model and the domain model.
-func mapToDomain(row orderRow, items []orderItemRow) *order.Order {
+func mapToDomain(row orderRow, items []orderItemRow, fills []fillRow) *order.Order {
 	// Implementation would reconstitute the aggregate from persisted data
 	// using internal constructors or factory methods
 	return nil // Placeholder
@@ -0,0 +1,81 @@
+// internal/infrastructure/postgres/outbox_store.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"myapp/internal/application/outbox"
+
+	"github.com/google/uuid"
+)
+
+// OutboxStore is a PostgreSQL implementation of outbox.Store. Rows are
+// written into the event_outbox table within the caller's transaction so
+// they commit atomically with whatever aggregate change produced them.
+type OutboxStore struct {
+	db *sql.DB
+}
+
+// NewOutboxStore creates an OutboxStore with the given database connection.
+func NewOutboxStore(db *sql.DB) *OutboxStore {
+	return &OutboxStore{db: db}
+}
+
+// Save writes events into event_outbox using tx.
+func (s *OutboxStore) Save(ctx context.Context, tx *sql.Tx, events []outbox.EncodedEvent) error {
+	for _, event := range events {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO event_outbox (id, event_type, payload, attempts, next_attempt_at, dead_letter, created_at)
+             VALUES ($1, $2, $3, 0, now(), false, now())`,
+			uuid.New().String(), event.EventType, event.Payload)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindUnpublished returns up to limit rows that are not yet published,
+// not dead-lettered, and due for a delivery attempt.
+func (s *OutboxStore) FindUnpublished(ctx context.Context, limit int) ([]outbox.Record, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, event_type, payload, attempts, next_attempt_at, dead_letter, created_at
+         FROM event_outbox
+         WHERE published_at IS NULL AND dead_letter = false AND next_attempt_at <= now()
+         ORDER BY created_at
+         LIMIT $1`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []outbox.Record
+	for rows.Next() {
+		var r outbox.Record
+		if err := rows.Scan(&r.ID, &r.EventType, &r.Payload, &r.Attempts, &r.NextAttemptAt, &r.DeadLetter, &r.CreatedAt); err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	return records, rows.Err()
+}
+
+// MarkPublished marks a row as successfully delivered.
+func (s *OutboxStore) MarkPublished(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE event_outbox SET published_at = now() WHERE id = $1`, id)
+	return err
+}
+
+// MarkFailed records a failed delivery attempt, either scheduling the
+// next retry or moving the row to the dead-letter state.
+func (s *OutboxStore) MarkFailed(ctx context.Context, id string, attempts int, nextAttemptAt time.Time, deadLetter bool) error {
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE event_outbox
+         SET attempts = $2, next_attempt_at = $3, dead_letter = $4
+         WHERE id = $1`,
+		id, attempts, nextAttemptAt, deadLetter)
+	return err
+}
@@ -0,0 +1,75 @@
+// internal/infrastructure/postgres/payment_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"myapp/internal/domain/payment"
+)
+
+// PaymentRepository is a PostgreSQL implementation of payment.Repository.
+// It persists Payment aggregates to the payments table, independently of
+// OrderRepository's orders table, keeping the payment and order bounded
+// contexts on separate storage as well as separate domain packages.
+type PaymentRepository struct {
+	db *sql.DB
+}
+
+// NewPaymentRepository creates a PaymentRepository with the given
+// database connection.
+func NewPaymentRepository(db *sql.DB) *PaymentRepository {
+	return &PaymentRepository{db: db}
+}
+
+// FindByID retrieves a Payment aggregate by its identifier.
+// Returns nil, nil if no payment exists with the given ID.
+// Returns an error if the database query or row mapping fails.
+func (r *PaymentRepository) FindByID(ctx context.Context, id payment.PaymentID) (*payment.Payment, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT id, order_id, amount_minor, currency, provider_name, provider_reference, status, created_at
+         FROM payments WHERE id = $1`, id.String())
+
+	var p paymentRow
+	if err := row.Scan(&p.ID, &p.OrderID, &p.AmountMinor, &p.Currency, &p.ProviderName, &p.ProviderReference, &p.Status, &p.CreatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return mapPaymentToDomain(p)
+}
+
+// Save persists a Payment aggregate using an upsert strategy. Creates a
+// new record if one doesn't exist, or updates the existing record's
+// status.
+func (r *PaymentRepository) Save(ctx context.Context, p *payment.Payment) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO payments (id, order_id, amount_minor, currency, provider_name, provider_reference, status, created_at)
+         VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+         ON CONFLICT (id) DO UPDATE SET
+           status = EXCLUDED.status`,
+		p.ID().String(), p.OrderID().String(), p.Amount().MinorUnits(), p.Amount().Currency().Code(),
+		p.Provider().Name(), p.Provider().Reference(), p.Status(), p.CreatedAt())
+	return err
+}
+
+// paymentRow represents the database schema for payments.
+type paymentRow struct {
+	ID                string
+	OrderID           string
+	AmountMinor       int64
+	Currency          string
+	ProviderName      string
+	ProviderReference string
+	Status            string
+	CreatedAt         string
+}
+
+// mapPaymentToDomain converts a database row to the Payment aggregate.
+func mapPaymentToDomain(row paymentRow) (*payment.Payment, error) {
+	// Implementation would reconstitute the aggregate from persisted data
+	// using internal constructors or factory methods
+	return nil, nil // Placeholder
+}
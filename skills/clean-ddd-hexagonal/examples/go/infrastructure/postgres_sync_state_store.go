@@ -0,0 +1,49 @@
+// internal/infrastructure/postgres/sync_state_store.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"myapp/internal/application/sync"
+)
+
+// SyncStateStore is a PostgreSQL implementation of sync.StateStore,
+// persisting one row per external source in the sync_state table.
+type SyncStateStore struct {
+	db *sql.DB
+}
+
+// NewSyncStateStore creates a SyncStateStore with the given database connection.
+func NewSyncStateStore(db *sql.DB) *SyncStateStore {
+	return &SyncStateStore{db: db}
+}
+
+// LoadCursor returns the persisted cursor for source, or the zero Cursor
+// (with SourceName set) if none has been saved yet.
+func (s *SyncStateStore) LoadCursor(ctx context.Context, source string) (sync.Cursor, error) {
+	row := s.db.QueryRowContext(ctx,
+		`SELECT source_name, last_order_id, last_sync_at
+         FROM sync_state WHERE source_name = $1`, source)
+
+	var c sync.Cursor
+	if err := row.Scan(&c.SourceName, &c.LastOrderID, &c.LastSyncAt); err != nil {
+		if err == sql.ErrNoRows {
+			return sync.Cursor{SourceName: source}, nil
+		}
+		return sync.Cursor{}, err
+	}
+	return c, nil
+}
+
+// SaveCursor upserts cursor into the sync_state table.
+func (s *SyncStateStore) SaveCursor(ctx context.Context, cursor sync.Cursor) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO sync_state (source_name, last_order_id, last_sync_at)
+         VALUES ($1, $2, $3)
+         ON CONFLICT (source_name) DO UPDATE SET
+           last_order_id = EXCLUDED.last_order_id,
+           last_sync_at = EXCLUDED.last_sync_at`,
+		cursor.SourceName, cursor.LastOrderID, cursor.LastSyncAt)
+	return err
+}